@@ -10,6 +10,13 @@ TARGET_SERVICE_PATH="${SYSTEMD_USER_DIR}/${SERVICE_FILE}"
 
 echo "Deploying ${SERVICE_NAME} systemd user service..."
 
+# Stamp the commit and build date into the package so `sat-x version` reports them
+echo "Writing build info..."
+(cd "${PROJECT_DIR}" && uv run python -m sat_x.build_info)
+if [ $? -ne 0 ]; then
+    echo "WARNING: Failed to write build info. Version info will fall back to git."
+fi
+
 # Check if the source service file exists
 if [ ! -f "${SOURCE_SERVICE_PATH}" ]; then
     echo "ERROR: Service file not found at ${SOURCE_SERVICE_PATH}"
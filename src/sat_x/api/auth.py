@@ -0,0 +1,25 @@
+# src/sat_x/api/auth.py
+import secrets
+
+from fastapi import Depends, HTTPException, status
+from fastapi.security import HTTPAuthorizationCredentials, HTTPBearer
+
+from ..config import Settings, get_settings
+
+# auto_error=False so requests without a header reach us when auth is disabled
+bearer_scheme = HTTPBearer(auto_error=False)
+
+async def verify_token(
+    credentials: HTTPAuthorizationCredentials | None = Depends(bearer_scheme),
+    settings: Settings = Depends(get_settings)
+):
+    """Rejects the request unless it carries the configured bearer token. No-op if no token is configured."""
+    expected = settings.api.token
+    if not expected:
+        return
+    if credentials is None or not secrets.compare_digest(credentials.credentials, expected):
+        raise HTTPException(
+            status_code=status.HTTP_401_UNAUTHORIZED,
+            detail="Invalid or missing API token.",
+            headers={"WWW-Authenticate": "Bearer"},
+        )
@@ -1,13 +1,16 @@
 # src/sat_x/api/routes.py
 import datetime
-from typing import Optional
+from typing import Any, Optional
 
 from fastapi import APIRouter, Depends, HTTPException, Query
 from sqlalchemy.ext.asyncio import AsyncSession
 
+from ..config import Settings, get_settings
 from ..database import get_db_session
 from ..repositories import MetricRepository
+from ..version import get_build_info
 from . import schemas  # Import the schemas we just defined
+from .auth import verify_token
 
 # Create an API router
 router = APIRouter()
@@ -25,6 +28,20 @@ async def health_check():
     """Returns a simple 'OK' status."""
     return schemas.HealthCheckResponse(status="OK")
 
+# --- Version Endpoint ---
+
+@router.get(
+    "/version",
+    response_model=schemas.VersionResponse,
+    summary="Get Version",
+    description="Returns the running version, git commit, and a fingerprint of the active configuration.",
+    tags=["Health"],
+    dependencies=[Depends(verify_token)]
+)
+async def get_version_info(settings: Settings = Depends(get_settings)):
+    """Lets operators verify exactly what build and config are deployed."""
+    return schemas.VersionResponse(**get_build_info(settings))
+
 # --- Metrics Endpoints ---
 
 @router.get(
@@ -32,7 +49,8 @@ async def health_check():
     response_model=Optional[schemas.MetricRead], # Can be None if no metrics yet
     summary="Get Latest Metric",
     description="Retrieves the most recently recorded system metric.",
-    tags=["Metrics"]
+    tags=["Metrics"],
+    dependencies=[Depends(verify_token)]
 )
 async def get_latest_metric(
     session: AsyncSession = Depends(get_db_session)
@@ -56,7 +74,8 @@ async def get_latest_metric(
     response_model=list[schemas.MetricRead],
     summary="Get Metrics in Time Range",
     description="Retrieves system metrics recorded within a specific time window.",
-    tags=["Metrics"]
+    tags=["Metrics"],
+    dependencies=[Depends(verify_token)]
 )
 async def get_metrics_in_range(
     start_time: datetime.datetime = Query(..., description="Start timestamp (ISO 8601 format)"),
@@ -76,4 +95,18 @@ async def get_metrics_in_range(
     # Pydantic automatically converts the list of ORM models
     return metrics
 
+# --- Config Endpoint ---
+
+@router.get(
+    "/config",
+    response_model=dict[str, Any],
+    summary="Get Active Configuration",
+    description="Returns the configuration the service is running with. Secrets are redacted.",
+    tags=["Config"],
+    dependencies=[Depends(verify_token)]
+)
+async def get_config(settings: Settings = Depends(get_settings)) -> dict[str, Any]:
+    """Dumps the active settings with credentials (tokens, webhook URLs and secrets, DB passwords) redacted."""
+    return settings.redacted_dump()
+
 # Add more endpoints as needed, e.g., get metric by ID, list all (paginated)
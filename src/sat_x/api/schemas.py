@@ -33,6 +33,13 @@ class HealthCheckResponse(BaseModel):
     """Schema for the health check endpoint response."""
     status: str = Field("OK", example="OK", description="Indicates the service status")
 
+class VersionResponse(BaseModel):
+    """Schema for the version endpoint response."""
+    version: str = Field(..., example="0.2.0", description="Installed sat-x version")
+    commit: str | None = Field(None, example="91b023f", description="Git commit the build came from, suffixed '-dirty' for uncommitted changes")
+    build_date: str | None = Field(None, example="2025-05-26T12:00:00+00:00", description="When build info was stamped, if it was")
+    config_fingerprint: str = Field(..., example="3f2a9c1b7d40", description="Short hash of the active configuration")
+
 # You might add schemas for pagination or bulk responses later
 class PaginatedMetricsResponse(BaseModel):
     total: int
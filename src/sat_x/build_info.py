@@ -0,0 +1,45 @@
+import datetime
+import subprocess
+from pathlib import Path
+
+# Generated at build/deploy time by `python -m sat_x.build_info`; not checked in
+BUILD_INFO_PATH = Path(__file__).resolve().parent / "_build_info.py"
+SOURCE_DIR = Path(__file__).resolve().parent.parent.parent
+
+def _git(*args: str) -> str:
+    result = subprocess.run(
+        ["git", *args],
+        cwd=SOURCE_DIR,
+        capture_output=True,
+        text=True,
+        timeout=5,
+        check=True,
+    )
+    return result.stdout.strip()
+
+def git_commit() -> str | None:
+    """Returns the short commit of the source checkout, suffixed with '-dirty' if it has uncommitted changes."""
+    # Only trust git if the package lives in its own checkout, not e.g. inside site-packages of another repo
+    if not (SOURCE_DIR / ".git").exists():
+        return None
+    try:
+        commit = _git("rev-parse", "--short", "HEAD")
+        if _git("status", "--porcelain", "--untracked-files=no"):
+            commit += "-dirty"
+        return commit or None
+    except Exception:
+        return None
+
+def write_build_info(path: Path = BUILD_INFO_PATH) -> Path:
+    """Writes the current commit and build date into a module shipped with the package."""
+    commit = git_commit()
+    build_date = datetime.datetime.now(datetime.timezone.utc).isoformat(timespec="seconds")  # noqa: UP017
+    path.write_text(
+        "# Generated by `python -m sat_x.build_info`. Do not edit.\n"
+        f"COMMIT = {commit!r}\n"
+        f"BUILD_DATE = {build_date!r}\n"
+    )
+    return path
+
+if __name__ == "__main__":
+    print(f"Wrote build info to {write_build_info()}")
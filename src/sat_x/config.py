@@ -1,15 +1,23 @@
 from pathlib import Path
+from typing import Any, Literal
 
 import yaml
 from pydantic import BaseModel, Field, validator
+from sqlalchemy.engine import make_url
+
+from .units import TemperatureUnit
 
 # Base directory of the project
 BASE_DIR = Path(__file__).resolve().parent.parent.parent
 DEFAULT_CONFIG_PATH = BASE_DIR / "config" / "settings.yaml"
 
+_REDACTED = "***"
+
 class ApiSettings(BaseModel):
     host: str = "127.0.0.1"
     port: int = 8000
+    # Optional bearer token required by all endpoints except /health
+    token: str | None = None
     # Example for CORS origins
     # cors_origins: Optional[List[HttpUrl]] = None
 
@@ -20,6 +28,17 @@ class DatabaseSettings(BaseModel):
 class MetricsTaskSettings(BaseModel):
     enabled: bool = True
     interval_seconds: int = Field(60, gt=0) # Ensure interval is positive
+    offset_seconds: float = Field(0, ge=0) # Delay before the first run
+
+class RetentionTaskSettings(BaseModel):
+    enabled: bool = True
+    interval_seconds: int = Field(3600, gt=0) # How often to check disk space and prune
+    offset_seconds: float = Field(0, ge=0) # Delay before the first run
+    max_age_days: int | None = Field(30, gt=0) # Delete metrics older than this; None keeps them forever
+    min_free_percent: float = Field(10, ge=0, le=100) # Below this, prune oldest metrics and decimate storage
+    prune_batch_size: int = Field(1000, gt=0) # Oldest records deleted per run while space is low
+    min_rows_kept: int = Field(10000, ge=0) # Low-space pruning never deletes below this many records
+    low_space_decimation: int = Field(10, gt=0) # While space is low, store only every Nth sample
 
 # --- Fan Control Settings ---
 class FanCurvePoint(BaseModel):
@@ -30,6 +49,7 @@ class FanCurvePoint(BaseModel):
 class FanControlSettings(BaseModel):
     enabled: bool = Field(False, description="Enable/disable automatic fan control.")
     interval_seconds: int = Field(10, gt=0, description="How often to check temp and adjust fan.")
+    offset_seconds: float = Field(0, ge=0, description="Delay before the first check, to stagger it from other tasks.")
     # Note: These paths are typical but might need verification on the target system
     control_path: str | None = Field("/sys/class/hwmon/hwmon0/pwm1", description="Sysfs path to write PWM value (0-255).")
     enable_path: str | None = Field("/sys/class/hwmon/hwmon0/pwm1_enable", description="Sysfs path to enable/disable manual PWM control (e.g., write '1').")
@@ -48,8 +68,78 @@ class FanControlSettings(BaseModel):
             raise ValueError('Fan curve points must be sorted by temperature.')
         return v
 
+# --- Alert Settings ---
+# Keys returned by MetricsService.get_system_metrics, mirroring the Metric model columns
+METRIC_KEYS = (
+    "cpu_percent",
+    "memory_percent",
+    "disk_usage_percent",
+    "cpu_temp_celsius",
+    "fan_speed_percent",
+)
+
+class AlertRule(BaseModel):
+    name: str = Field(..., description="Unique name used in alert events.")
+    metric: str = Field(..., description="Metric key to watch, e.g. 'cpu_temp_celsius'.")
+    # 'missing' fires when the metric could not be collected
+    operator: Literal[">", ">=", "<", "<=", "missing"] = Field(..., description="Comparison applied to the metric value.")
+    threshold: float | None = Field(None, description="Value compared against; unused for 'missing'.")
+    for_seconds: float = Field(0, ge=0, description="How long the condition must hold before the alert fires.")
+    actions: list[str] = Field(default_factory=lambda: ["log"], description="Notifiers to send events to, e.g. 'log'.")
+
+    @validator('metric')
+    def check_metric_known(cls, v):
+        if v not in METRIC_KEYS:
+            raise ValueError(f'Unknown metric "{v}". Expected one of: {", ".join(METRIC_KEYS)}.')
+        return v
+
+    @validator('threshold', always=True)
+    def check_threshold_set(cls, v, values):
+        if v is None and values.get('operator') != 'missing':
+            raise ValueError('A threshold is required unless the operator is "missing".')
+        return v
+
+class WebhookSettings(BaseModel):
+    name: str = Field(..., description="Action name rules use to send events to this webhook.")
+    url: str = Field(..., description="URL alert events are POSTed to as JSON.")
+    secret: str | None = Field(None, description="If set, requests are signed with HMAC-SHA256 in the X-Sat-X-Signature header.")
+    max_retries: int = Field(3, ge=0, description="Retries after a failed delivery, with exponential backoff.")
+    timeout_seconds: float = Field(10, gt=0, description="Timeout for each delivery attempt.")
+
+class AlertSettings(BaseModel):
+    enabled: bool = Field(False, description="Enable/disable alert rule evaluation.")
+    rules: list[AlertRule] = Field(default_factory=list, description="Rules evaluated against each collected metric sample.")
+    webhooks: list[WebhookSettings] = Field(default_factory=list, description="Webhook notifiers, referenced by name in rule actions.")
+
+    @validator('rules')
+    def check_rule_names_unique(cls, v):
+        names = [rule.name for rule in v]
+        if len(names) != len(set(names)):
+            raise ValueError('Alert rule names must be unique.')
+        return v
+
+    @validator('webhooks', always=True)
+    def check_actions_known(cls, v, values):
+        webhook_names = [webhook.name for webhook in v]
+        if "log" in webhook_names:
+            raise ValueError('Webhook name "log" is reserved for the built-in log notifier.')
+        if len(webhook_names) != len(set(webhook_names)):
+            raise ValueError('Webhook names must be unique.')
+        # Rules are validated first; they're missing here if they failed validation
+        known_actions = {"log", *webhook_names}
+        for rule in values.get('rules', []):
+            unknown = [action for action in rule.actions if action not in known_actions]
+            if unknown:
+                raise ValueError(f'Alert rule "{rule.name}" references unknown action(s): {", ".join(unknown)}.')
+        return v
+
+class DisplaySettings(BaseModel):
+    # Only affects human-facing output (`sat-x watch`, text logs); stored metrics and the API stay in SI/Celsius
+    temperature_unit: TemperatureUnit = Field("C", description="Temperature unit for human-readable output: C, F or K.")
+
 class TasksSettings(BaseModel):
     metrics: MetricsTaskSettings
+    retention: RetentionTaskSettings = Field(default_factory=RetentionTaskSettings)
     # Add other task configurations here
     # other_task: OtherTaskSettings
 
@@ -58,6 +148,8 @@ class Settings(BaseModel):
     database: DatabaseSettings
     tasks: TasksSettings
     fan_control: FanControlSettings | None = None # Added Fan Control
+    alerts: AlertSettings | None = None
+    display: DisplaySettings = Field(default_factory=DisplaySettings)
     # Add other top-level settings here
     # logging: LoggingSettings
 
@@ -78,6 +170,20 @@ class Settings(BaseModel):
             print(f"Error loading configuration from {path}: {e}")
             raise
 
+    def redacted_dump(self) -> dict[str, Any]:
+        """Dumps the settings with credentials (tokens, webhook URLs and secrets, DB passwords) redacted."""
+        config = self.model_dump()
+        if config["api"].get("token"):
+            config["api"]["token"] = _REDACTED
+        # Database URLs can embed a password
+        config["database"]["url"] = make_url(self.database.url).render_as_string(hide_password=True)
+        for webhook in (config.get("alerts") or {}).get("webhooks", []):
+            # Slack/Discord style webhook URLs are themselves the credential
+            webhook["url"] = _REDACTED
+            if webhook.get("secret"):
+                webhook["secret"] = _REDACTED
+        return config
+
 # Load settings globally on import
 # Consider dependency injection for more complex scenarios
 try:
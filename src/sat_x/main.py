@@ -1,6 +1,8 @@
 import asyncio
+import json
 import time
 from contextlib import asynccontextmanager
+from typing import get_args
 
 import typer
 import uvicorn
@@ -15,8 +17,13 @@ setup_logging()
 from .api import routes as api_routes
 from .config import Settings, get_settings
 from .database import engine, init_db
+from .services.alert_service import alert_service
 from .tasks.fan_control_task import run_fan_control_task
 from .tasks.metrics_collector import run_metrics_collector_task
+from .tasks.retention_task import run_retention_task
+from .units import TemperatureUnit
+from .version import get_build_info, get_version
+from .watch import run_watch
 
 # List to keep track of background tasks
 background_tasks = set()
@@ -28,6 +35,8 @@ async def lifespan(app: FastAPI):
     settings: Settings = get_settings()  # Use a direct call
 
     logger.info(f"Application starting with version: {app.version}")
+    build_info = get_build_info(settings)
+    logger.info(f"Commit: {build_info['commit']}, config fingerprint: {build_info['config_fingerprint']}")
     logger.info(f"Using database: {settings.database.url}")
 
     # Initialize Database
@@ -49,6 +58,16 @@ async def lifespan(app: FastAPI):
     else:
         logger.info("Metrics collector task is disabled in settings.")
 
+    # Start Retention Task
+    if settings.tasks and settings.tasks.retention.enabled:
+        retention_task = asyncio.create_task(run_retention_task(settings))
+        background_tasks.add(retention_task)
+        logger.info("Retention task scheduled.")
+        # Keep track of the task to cancel it properly on shutdown
+        retention_task.add_done_callback(background_tasks.discard)
+    else:
+        logger.info("Retention task is disabled in settings.")
+
     # Start Fan Control Task
     if settings.fan_control and settings.fan_control.enabled:
         fan_task = asyncio.create_task(run_fan_control_task(settings))
@@ -93,6 +112,9 @@ async def lifespan(app: FastAPI):
         await asyncio.gather(*background_tasks, return_exceptions=True)
         logger.info("All background tasks awaited.")
 
+    # Drop any alert notifications still retrying
+    await alert_service.cancel_notifications()
+
     await engine.dispose()  # Correctly dispose of the engine's connections
     logger.info("Database connection pool closed.")
     logger.info("Application shutdown complete.")
@@ -102,7 +124,7 @@ async def lifespan(app: FastAPI):
 app_instance = FastAPI(
     title="sat-x API",
     description="API for monitoring and managing sat-x tasks and data.",
-    version="0.0.0",
+    version=get_version(),
     lifespan=lifespan,  # Use the lifespan context manager
     openapi_url="/api/v1/openapi.json",  # Default OpenAPI spec location
 )
@@ -203,6 +225,35 @@ def init_db_cli():
     asyncio.run(_init())
 
 
+@cli_app.command()
+def watch(
+    interval: float = typer.Option(default=1.0, min=0.1, help="Seconds between refreshes."),
+    history: int = typer.Option(default=60, min=1, help="Number of readings shown in each sparkline."),
+    temp_unit: str | None = typer.Option(default=None, help="Temperature unit (C, F or K). Defaults to display.temperature_unit."),
+):
+    """Shows live system metrics in the terminal, refreshing in place."""
+    runtime_settings = get_settings()
+    final_temp_unit = temp_unit.upper() if temp_unit is not None else runtime_settings.display.temperature_unit
+    if final_temp_unit not in get_args(TemperatureUnit):
+        raise typer.BadParameter(f"Unknown temperature unit '{temp_unit}'. Use C, F or K.", param_hint="--temp-unit")
+    run_watch(interval, history, final_temp_unit)
+
+
+@cli_app.command()
+def version(
+    as_json: bool = typer.Option(False, "--json", help="Print build info as JSON."),
+):
+    """Shows the sat-x version, git commit, build date, and config fingerprint."""
+    info = get_build_info(get_settings())
+    if as_json:
+        typer.echo(json.dumps(info))
+    else:
+        typer.echo(
+            f"sat-x {info['version']} (commit: {info['commit'] or 'unknown'}, "
+            f"built: {info['build_date'] or 'unknown'}, config: {info['config_fingerprint']})"
+        )
+
+
 # Add other CLI commands here (e.g., run tasks manually, manage users)
 
 # --- Main execution ---
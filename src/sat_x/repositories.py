@@ -2,7 +2,7 @@ import datetime
 from abc import ABC, abstractmethod
 from typing import Generic, TypeVar
 
-from sqlalchemy import select
+from sqlalchemy import delete, func, select
 from sqlalchemy.ext.asyncio import AsyncSession
 
 from .database import Base
@@ -68,3 +68,21 @@ class MetricRepository:
         stmt = select(Metric).order_by(Metric.timestamp.desc()).limit(limit)
         result = await self._session.execute(stmt)
         return list(result.scalars().all())
+
+    async def count(self) -> int:
+        """Returns the total number of metric records."""
+        result = await self._session.execute(select(func.count(Metric.id)))
+        return result.scalar_one()
+
+    async def delete_older_than(self, cutoff: datetime.datetime) -> int:
+        """Deletes metrics recorded before `cutoff`, returning the number removed."""
+        stmt = delete(Metric).where(Metric.timestamp < cutoff)
+        result = await self._session.execute(stmt)
+        return result.rowcount
+
+    async def delete_oldest(self, count: int) -> int:
+        """Deletes the `count` oldest metrics, returning the number removed."""
+        oldest_ids = select(Metric.id).order_by(Metric.timestamp.asc()).limit(count)
+        stmt = delete(Metric).where(Metric.id.in_(oldest_ids))
+        result = await self._session.execute(stmt)
+        return result.rowcount
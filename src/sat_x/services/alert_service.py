@@ -0,0 +1,152 @@
+import asyncio
+import datetime
+import operator
+import time
+from abc import ABC, abstractmethod
+from dataclasses import dataclass
+from typing import Literal
+
+from loguru import logger
+
+from ..config import AlertRule, AlertSettings, WebhookSettings
+
+_COMPARISONS = {
+    ">": operator.gt,
+    ">=": operator.ge,
+    "<": operator.lt,
+    "<=": operator.le,
+}
+
+@dataclass
+class AlertEvent:
+    """A change in state of an alert rule."""
+    rule: str
+    metric: str
+    state: Literal["firing", "resolved"]
+    value: float | None
+    threshold: float | None
+    timestamp: datetime.datetime
+
+class Notifier(ABC):
+    """Base class for alert notification backends."""
+
+    @abstractmethod
+    async def notify(self, event: AlertEvent):
+        raise NotImplementedError
+
+class LogNotifier(Notifier):
+    """Writes alert events to the application log."""
+
+    async def notify(self, event: AlertEvent):
+        if event.state == "firing":
+            logger.warning(f"ALERT '{event.rule}' firing: {event.metric}={event.value} (threshold: {event.threshold})")
+        else:
+            logger.info(f"ALERT '{event.rule}' resolved: {event.metric}={event.value}")
+
+class AlertService:
+    """Service responsible for evaluating alert rules against collected metrics."""
+
+    def __init__(self):
+        self._notifiers: dict[str, Notifier] = {"log": LogNotifier()}
+        # Monotonic time each rule's condition was first seen true
+        self._pending_since: dict[str, float] = {}
+        self._firing: set[str] = set()
+        # Notifications run in the background; keep references so they aren't garbage collected
+        self._pending_notifications: set[asyncio.Task] = set()
+
+    def register_notifier(self, name: str, notifier: Notifier):
+        """Registers a notifier that rules can reference in their `actions`."""
+        self._notifiers[name] = notifier
+
+    def configure_webhooks(self, webhooks: list[WebhookSettings]):
+        """Registers a webhook notifier for each configured webhook."""
+        # Imported here as the webhook module depends on this one
+        from .webhook_notifier import WebhookNotifier
+
+        for webhook in webhooks:
+            self.register_notifier(webhook.name, WebhookNotifier(webhook))
+            # The URL often embeds a token, so only log the name
+            logger.info(f"Registered webhook notifier '{webhook.name}'.")
+
+    def _condition_met(self, rule: AlertRule, value: float | None) -> bool:
+        if rule.operator == "missing":
+            return value is None
+        if value is None or rule.threshold is None:
+            # Can't compare a missing value; the 'missing' operator covers that case
+            return False
+        return _COMPARISONS[rule.operator](value, rule.threshold)
+
+    def evaluate(
+        self,
+        metrics: dict[str, float | None],
+        rules: list[AlertRule],
+        now: float | None = None
+    ) -> list[tuple[AlertRule, AlertEvent]]:
+        """Updates rule state from a metric sample and returns any events raised."""
+        now = time.monotonic() if now is None else now
+        events: list[tuple[AlertRule, AlertEvent]] = []
+
+        for rule in rules:
+            value = metrics.get(rule.metric)
+            state: Literal["firing", "resolved"] | None = None
+
+            if self._condition_met(rule, value):
+                since = self._pending_since.setdefault(rule.name, now)
+                if rule.name not in self._firing and now - since >= rule.for_seconds:
+                    self._firing.add(rule.name)
+                    state = "firing"
+            else:
+                self._pending_since.pop(rule.name, None)
+                if rule.name in self._firing:
+                    self._firing.discard(rule.name)
+                    state = "resolved"
+
+            if state is not None:
+                events.append((rule, AlertEvent(
+                    rule=rule.name,
+                    metric=rule.metric,
+                    state=state,
+                    value=value,
+                    threshold=rule.threshold,
+                    timestamp=datetime.datetime.now(datetime.timezone.utc),  # noqa: UP017
+                )))
+
+        return events
+
+    async def _notify(self, action: str, notifier: Notifier, event: AlertEvent):
+        try:
+            await notifier.notify(event)
+        except Exception as e:
+            # One failing notifier shouldn't stop the others
+            logger.error(f"Notifier '{action}' failed for alert '{event.rule}': {e}")
+
+    async def process(self, metrics: dict[str, float | None], config: AlertSettings):
+        """Evaluates the configured rules and sends any events to their notifiers in the background."""
+        if not config.enabled or not config.rules:
+            logger.debug("Alerts disabled or no rules configured. Skipping evaluation.")
+            return
+
+        for rule, event in self.evaluate(metrics, config.rules):
+            for action in rule.actions:
+                notifier = self._notifiers.get(action)
+                if notifier is None:
+                    logger.warning(f"Alert rule '{rule.name}' references unknown action '{action}'.")
+                    continue
+                # Don't hold up metric collection on slow notifiers (e.g. webhook retries)
+                task = asyncio.create_task(self._notify(action, notifier, event))
+                self._pending_notifications.add(task)
+                task.add_done_callback(self._pending_notifications.discard)
+
+    async def wait_for_notifications(self):
+        """Waits for all in-flight notifications to finish."""
+        if self._pending_notifications:
+            await asyncio.gather(*self._pending_notifications, return_exceptions=True)
+
+    async def cancel_notifications(self):
+        """Cancels in-flight notifications, e.g. on shutdown."""
+        for task in list(self._pending_notifications):
+            task.cancel()
+        await self.wait_for_notifications()
+
+# Singleton instance
+alert_service = AlertService()
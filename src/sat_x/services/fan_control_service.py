@@ -3,6 +3,7 @@ import math
 from loguru import logger
 
 from ..config import FanControlSettings
+from ..units import TemperatureUnit, format_temperature
 
 _FAN_MAX_PWM = 255
 
@@ -36,8 +37,8 @@ class FanControlService:
         # Typically, '1' enables manual PWM control
         return self._write_to_sysfs(config.enable_path, "1")
 
-    def adjust_fan_speed(self, current_temp: float, config: FanControlSettings):
-        """Determines and sets the fan speed based on the temp curve."""
+    def adjust_fan_speed(self, current_temp: float, config: FanControlSettings, temperature_unit: TemperatureUnit = "C"):
+        """Determines and sets the fan speed based on the temp curve (in Celsius); `temperature_unit` only affects logs."""
         if not config.enabled or not config.curve:
             logger.debug("Fan control disabled or curve is empty. Skipping adjustment.")
             return
@@ -56,7 +57,7 @@ class FanControlService:
 
         # Only write if the PWM value needs to change
         if target_pwm != self._last_pwm_written:
-            logger.info(f"CPU Temp: {current_temp:.1f}°C. Setting fan speed to {target_speed_percent:.0f}% (PWM: {target_pwm})")
+            logger.info(f"CPU Temp: {format_temperature(current_temp, temperature_unit)}. Setting fan speed to {target_speed_percent:.0f}% (PWM: {target_pwm})")
 
             # Ensure manual mode is set (might be optional depending on hardware)
             # Doing this every time ensures it stays in manual mode
@@ -70,7 +71,7 @@ class FanControlService:
                 # Failed to write PWM, clear last written value to force retry next time
                 self._last_pwm_written = None
         else:
-             logger.debug(f"CPU Temp: {current_temp:.1f}°C. Target PWM {target_pwm} is same as last written. No change needed.")
+             logger.debug(f"CPU Temp: {format_temperature(current_temp, temperature_unit)}. Target PWM {target_pwm} is same as last written. No change needed.")
 
 
 # Singleton instance
@@ -2,6 +2,8 @@
 import psutil
 from loguru import logger
 
+from ..config import METRIC_KEYS
+
 # Define the expected path for RPi5 fan speed PWM value
 # This might need adjustment depending on the exact kernel/OS setup
 _FAN_PWM_SYSFS_PATH = "/sys/class/thermal/cooling_device0/cur_state"
@@ -12,13 +14,7 @@ class MetricsService:
 
     def get_system_metrics(self) -> dict[str, float | None]:
         """Collects CPU, Memory, Disk usage, Temp, and Fan speed."""
-        metrics: dict[str, float | None] = {
-            "cpu_percent": None,
-            "memory_percent": None,
-            "disk_usage_percent": None,
-            "cpu_temp_celsius": None,
-            "fan_speed_percent": None,
-        }
+        metrics: dict[str, float | None] = dict.fromkeys(METRIC_KEYS)
         try:
             metrics["cpu_percent"] = psutil.cpu_percent(interval=0.1) # Short interval for responsiveness
         except Exception as e:
@@ -0,0 +1,43 @@
+from pathlib import Path
+
+import psutil
+from loguru import logger
+from sqlalchemy.engine import make_url
+
+
+class StorageService:
+    """Service responsible for monitoring free space where metrics are stored."""
+
+    def __init__(self):
+        # Set by the retention task; read by the metrics collector to decimate storage
+        self.low_space = False
+
+    def get_storage_path(self, database_url: str) -> Path:
+        """Returns the directory holding the database, falling back to the working directory."""
+        database = make_url(database_url).database
+        if not database or database == ":memory:":
+            return Path(".")
+        return Path(database).resolve().parent
+
+    def get_free_percent(self, database_url: str) -> float | None:
+        """Returns the free space percentage on the database's partition."""
+        path = self.get_storage_path(database_url)
+        try:
+            return 100.0 - psutil.disk_usage(str(path)).percent
+        except Exception as e:
+            logger.warning(f"Could not check free space for '{path}': {e}")
+            return None
+
+    def update_low_space(self, free_percent: float, min_free_percent: float) -> bool:
+        """Updates the low-space flag, logging transitions. Returns the new flag value."""
+        low_space = free_percent < min_free_percent
+        if low_space and not self.low_space:
+            logger.warning(f"Free space {free_percent:.1f}% is below {min_free_percent:.1f}%. Pruning old metrics and decimating storage.")
+        elif not low_space and self.low_space:
+            logger.info(f"Free space recovered to {free_percent:.1f}%. Resuming full-rate metric storage.")
+        self.low_space = low_space
+        return low_space
+
+
+# Singleton instance
+storage_service = StorageService()
@@ -0,0 +1,72 @@
+import asyncio
+import dataclasses
+import hashlib
+import hmac
+import json
+
+import httpx
+from loguru import logger
+
+from ..config import WebhookSettings
+from .alert_service import AlertEvent, Notifier
+
+SIGNATURE_HEADER = "X-Sat-X-Signature"
+
+# Indirection so tests can skip real backoff delays
+_sleep = asyncio.sleep
+
+def sign_payload(secret: str, body: bytes) -> str:
+    """Returns the HMAC-SHA256 signature header value for a request body."""
+    digest = hmac.new(secret.encode(), body, hashlib.sha256).hexdigest()
+    return f"sha256={digest}"
+
+def _is_retryable(status_code: int) -> bool:
+    """Server errors and rate limiting may clear up; other failures won't."""
+    return status_code == 429 or status_code >= 500
+
+class WebhookNotifier(Notifier):
+    """POSTs alert events as JSON to a webhook URL, retrying on failure."""
+
+    def __init__(self, config: WebhookSettings, transport: httpx.AsyncBaseTransport | None = None):
+        self._config = config
+        # Only overridden in tests, e.g. with httpx.MockTransport
+        self._transport = transport
+
+    def _build_request(self, event: AlertEvent) -> tuple[bytes, dict[str, str]]:
+        payload = dataclasses.asdict(event)
+        payload["timestamp"] = event.timestamp.isoformat()
+        body = json.dumps(payload).encode()
+        headers = {"Content-Type": "application/json"}
+        if self._config.secret:
+            headers[SIGNATURE_HEADER] = sign_payload(self._config.secret, body)
+        return body, headers
+
+    async def notify(self, event: AlertEvent):
+        body, headers = self._build_request(event)
+        attempts = self._config.max_retries + 1
+
+        async with httpx.AsyncClient(timeout=self._config.timeout_seconds, transport=self._transport) as client:
+            for attempt in range(1, attempts + 1):
+                try:
+                    response = await client.post(self._config.url, content=body, headers=headers)
+                except httpx.TransportError as e:
+                    error = str(e)
+                else:
+                    if response.is_success:
+                        logger.debug(f"Webhook '{self._config.name}' delivered alert '{event.rule}' ({event.state}).")
+                        return
+                    if not _is_retryable(response.status_code):
+                        # Retrying won't help if the endpoint rejects the request itself
+                        logger.error(
+                            f"Webhook '{self._config.name}' rejected alert '{event.rule}' "
+                            f"with HTTP {response.status_code}, not retrying."
+                        )
+                        return
+                    error = f"HTTP {response.status_code}"
+
+                logger.warning(f"Webhook '{self._config.name}' attempt {attempt}/{attempts} failed: {error}")
+                if attempt < attempts:
+                    # Exponential backoff: 1s, 2s, 4s, ...
+                    await _sleep(2 ** (attempt - 1))
+
+        logger.error(f"Webhook '{self._config.name}' gave up delivering alert '{event.rule}' after {attempts} attempts.")
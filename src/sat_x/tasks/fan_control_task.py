@@ -1,5 +1,3 @@
-import asyncio
-
 from loguru import logger
 
 from ..config import Settings
@@ -7,6 +5,7 @@ from ..services.fan_control_service import fan_control_service
 
 # Import the services needed
 from ..services.metrics_service import metrics_service
+from .scheduler import run_periodic
 
 
 async def run_fan_control_task(settings: Settings):
@@ -20,7 +19,8 @@ async def run_fan_control_task(settings: Settings):
          return
 
     interval = settings.fan_control.interval_seconds
-    logger.info(f"Starting fan control task with interval: {interval}s")
+    offset = settings.fan_control.offset_seconds
+    logger.info(f"Starting fan control task with interval: {interval}s, offset: {offset}s")
     logger.info(f"Fan control using control_path: {settings.fan_control.control_path}")
     logger.info(f"Fan control using enable_path: {settings.fan_control.enable_path}")
 
@@ -28,7 +28,7 @@ async def run_fan_control_task(settings: Settings):
     # This might fail due to permissions, the service will log errors
     fan_control_service.set_fan_manual_mode(settings.fan_control)
 
-    while True:
+    async def _adjust():
         try:
             # Get current CPU temperature
             # We get all metrics, but only need temp here
@@ -37,7 +37,7 @@ async def run_fan_control_task(settings: Settings):
 
             if cpu_temp is not None:
                 # Adjust fan speed based on the current temperature
-                fan_control_service.adjust_fan_speed(cpu_temp, settings.fan_control)
+                fan_control_service.adjust_fan_speed(cpu_temp, settings.fan_control, settings.display.temperature_unit)
             else:
                 logger.warning("Could not get CPU temperature. Skipping fan adjustment.")
 
@@ -45,4 +45,4 @@ async def run_fan_control_task(settings: Settings):
             # Catch broad exceptions here to prevent the loop from crashing
             logger.error(f"Unhandled error in fan control loop: {e}", exc_info=True)
 
-        await asyncio.sleep(interval)
+    await run_periodic("Fan control", interval, _adjust, offset=offset)
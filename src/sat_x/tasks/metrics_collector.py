@@ -1,5 +1,3 @@
-import asyncio
-
 from loguru import logger
 from sqlalchemy.ext.asyncio import AsyncSession
 
@@ -7,12 +5,18 @@ from ..config import Settings
 from ..database import AsyncSessionFactory  # Use the factory to create sessions
 from ..models import Metric
 from ..repositories import MetricRepository
+from ..services.alert_service import alert_service
 from ..services.metrics_service import metrics_service  # Import the service
+from ..services.storage_service import storage_service
+from .scheduler import run_periodic
 
 
-async def collect_and_store_metrics(session: AsyncSession):
-    """Collects metrics using the service and stores them using the repository."""
+async def collect_and_store_metrics(session: AsyncSession, store: bool = True) -> dict[str, float | None]:
+    """Collects metrics using the service, stores them using the repository, and returns them."""
     collected_data = metrics_service.get_system_metrics()
+    if not store:
+        logger.debug("Skipping metric storage for this sample (low disk space decimation).")
+        return collected_data
 
     # Create a Metric ORM object from the collected data
     metric = Metric(
@@ -33,6 +37,8 @@ async def collect_and_store_metrics(session: AsyncSession):
         await session.rollback()
         logger.error(f"Failed to store metrics: {e}", exc_info=True)
 
+    return collected_data
+
 async def run_metrics_collector_task(settings: Settings):
     """Periodically runs the metric collection and storage task."""
     if not settings.tasks.metrics.enabled:
@@ -40,14 +46,27 @@ async def run_metrics_collector_task(settings: Settings):
         return
 
     interval = settings.tasks.metrics.interval_seconds
-    logger.info(f"Starting metrics collector task with interval: {interval}s")
+    offset = settings.tasks.metrics.offset_seconds
+    logger.info(f"Starting metrics collector task with interval: {interval}s, offset: {offset}s")
+
+    if settings.alerts and settings.alerts.enabled:
+        alert_service.configure_webhooks(settings.alerts.webhooks)
+
+    decimation = settings.tasks.retention.low_space_decimation
+    sample_count = 0
 
-    while True:
+    async def _collect():
+        nonlocal sample_count
         try:
+            # While disk space is low, only every Nth sample is written
+            store = not storage_service.low_space or sample_count % decimation == 0
+            sample_count += 1
             async with AsyncSessionFactory() as session:
-                await collect_and_store_metrics(session)
+                collected_data = await collect_and_store_metrics(session, store=store)
+            if settings.alerts:
+                await alert_service.process(collected_data, settings.alerts)
         except Exception as e:
             # Catch broad exceptions here to prevent the loop from crashing
             logger.error(f"Unhandled error in metrics collector loop: {e}", exc_info=True)
 
-        await asyncio.sleep(interval)
+    await run_periodic("Metrics collector", interval, _collect, offset=offset)
@@ -0,0 +1,56 @@
+import datetime
+
+from loguru import logger
+from sqlalchemy.ext.asyncio import AsyncSession
+
+from ..config import RetentionTaskSettings, Settings
+from ..database import AsyncSessionFactory
+from ..repositories import MetricRepository
+from ..services.storage_service import storage_service
+from .scheduler import run_periodic
+
+
+async def prune_metrics(session: AsyncSession, config: RetentionTaskSettings, database_url: str):
+    """Applies the retention policy: drops expired metrics and frees space when it runs low."""
+    repo = MetricRepository(session)
+    try:
+        if config.max_age_days is not None:
+            cutoff = datetime.datetime.now(datetime.timezone.utc) - datetime.timedelta(days=config.max_age_days)  # noqa: UP017
+            deleted = await repo.delete_older_than(cutoff)
+            if deleted:
+                logger.info(f"Pruned {deleted} metric records older than {config.max_age_days} days.")
+
+        free_percent = storage_service.get_free_percent(database_url)
+        if free_percent is not None and storage_service.update_low_space(free_percent, config.min_free_percent):
+            # SQLite doesn't shrink the file on DELETE, so free space may never recover
+            # from pruning alone; keep a floor of recent history rather than deleting it all
+            prunable = max(0, await repo.count() - config.min_rows_kept)
+            if prunable:
+                deleted = await repo.delete_oldest(min(config.prune_batch_size, prunable))
+                logger.warning(f"Pruned {deleted} oldest metric records to free space.")
+            else:
+                logger.warning(f"Free space still low but only {config.min_rows_kept} metric records remain. Not pruning further.")
+
+        await session.commit()
+    except Exception as e:
+        await session.rollback()
+        logger.error(f"Failed to prune metrics: {e}", exc_info=True)
+
+async def run_retention_task(settings: Settings):
+    """Periodically enforces the metric retention and free-space policy."""
+    config = settings.tasks.retention
+    if not config.enabled:
+        logger.info("Retention task is disabled in settings.")
+        return
+
+    logger.info(f"Starting retention task with interval: {config.interval_seconds}s, offset: {config.offset_seconds}s")
+
+    async def _prune():
+        try:
+            async with AsyncSessionFactory() as session:
+                await prune_metrics(session, config, settings.database.url)
+        except Exception as e:
+            # Catch broad exceptions here to prevent the loop from crashing
+            logger.error(f"Unhandled error in retention loop: {e}", exc_info=True)
+
+    await run_periodic("Retention", config.interval_seconds, _prune, offset=config.offset_seconds)
@@ -0,0 +1,45 @@
+import asyncio
+import time
+from collections.abc import Awaitable, Callable
+
+from loguru import logger
+
+# Indirection so tests can drive the scheduler with a fake clock
+_now = time.monotonic
+_sleep = asyncio.sleep
+
+def skipped_runs(next_run: float, now: float, interval: float) -> int:
+    """Returns how many deadlines starting at `next_run` have already passed at `now`."""
+    if now <= next_run:
+        return 0
+    return int((now - next_run) // interval) + 1
+
+async def run_periodic(
+    name: str,
+    interval: float,
+    job: Callable[[], Awaitable[None]],
+    offset: float = 0.0,
+):
+    """
+    Runs `job` every `interval` seconds, starting `offset` seconds after the call.
+    Runs are scheduled against a fixed timeline so jitter doesn't accumulate,
+    and offsets keep tasks with the same interval from firing at the same time.
+    If a run overruns one or more deadlines, those runs are skipped and logged.
+    """
+    next_run = _now() + offset
+    missed = 0
+
+    while True:
+        await _sleep(max(0.0, next_run - _now()))
+        await job()
+
+        next_run += interval
+        skipped = skipped_runs(next_run, _now(), interval)
+        if skipped:
+            # Jump to the first deadline still in the future
+            next_run += skipped * interval
+            missed += skipped
+            logger.warning(
+                f"{name} task overran its {interval}s interval, skipped {skipped} run(s) "
+                f"({missed} missed in total)."
+            )
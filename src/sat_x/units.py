@@ -0,0 +1,21 @@
+from typing import Literal
+
+TemperatureUnit = Literal["C", "F", "K"]
+
+_TEMPERATURE_SYMBOLS: dict[str, str] = {"C": "°C", "F": "°F", "K": "K"}
+
+def convert_temperature(celsius: float, unit: TemperatureUnit) -> float:
+    """Converts a Celsius reading into the requested display unit."""
+    if unit == "F":
+        return celsius * 9.0 / 5.0 + 32.0
+    if unit == "K":
+        return celsius + 273.15
+    return celsius
+
+def temperature_symbol(unit: TemperatureUnit) -> str:
+    """Returns the display suffix for a temperature unit."""
+    return _TEMPERATURE_SYMBOLS[unit]
+
+def format_temperature(celsius: float, unit: TemperatureUnit) -> str:
+    """Formats a Celsius reading for human-readable output, e.g. '55.0°C'."""
+    return f"{convert_temperature(celsius, unit):.1f}{temperature_symbol(unit)}"
@@ -0,0 +1,55 @@
+import hashlib
+import importlib
+import json
+from functools import cache
+from importlib.metadata import PackageNotFoundError, version
+
+from .build_info import git_commit
+from .config import Settings
+
+PACKAGE_NAME = "sat-x"
+
+def get_version() -> str:
+    """Returns the installed package version."""
+    try:
+        return version(PACKAGE_NAME)
+    except PackageNotFoundError:
+        # Running from a source tree without an install
+        return "0.0.0+unknown"
+
+@cache
+def _stamped_build_info() -> tuple[str | None, str | None]:
+    """Returns the (commit, build date) written at build/deploy time, if any."""
+    try:
+        # Imported dynamically as the module only exists in stamped builds
+        build_info = importlib.import_module(f"{__package__}._build_info")
+    except ImportError:
+        return None, None
+    return build_info.COMMIT, build_info.BUILD_DATE
+
+@cache
+def get_commit() -> str | None:
+    """Returns the commit stamped at build time, falling back to the git checkout the code runs from."""
+    commit, _ = _stamped_build_info()
+    return commit or git_commit()
+
+def get_build_date() -> str | None:
+    """Returns when build info was stamped, or None for an unstamped source tree."""
+    return _stamped_build_info()[1]
+
+def config_fingerprint(settings: Settings) -> str:
+    """Returns a short hash of the validated settings, so deployments can be compared."""
+    # Hashing the parsed model means comments and formatting in the YAML don't change it.
+    # Credentials are redacted first, as a short unsalted hash could be brute-forced back to a token.
+    config = json.dumps(settings.redacted_dump(), sort_keys=True, default=str)
+    digest = hashlib.sha256(config.encode()).hexdigest()
+    return digest[:12]
+
+def get_build_info(settings: Settings) -> dict[str, str | None]:
+    """Collects version, commit, build date and config fingerprint for display."""
+    return {
+        "version": get_version(),
+        "commit": get_commit(),
+        "build_date": get_build_date(),
+        "config_fingerprint": config_fingerprint(settings),
+    }
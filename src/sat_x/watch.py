@@ -0,0 +1,67 @@
+import time
+from collections import deque
+from collections.abc import Sequence
+
+from .services.metrics_service import metrics_service
+from .units import TemperatureUnit, convert_temperature, temperature_symbol
+
+_SPARK_CHARS = "▁▂▃▄▅▆▇█"
+_CLEAR_SCREEN = "\033[H\033[J"
+
+# Metric key, label, unit, and the range used to scale its sparkline
+_WATCH_FIELDS: list[tuple[str, str, str, float, float]] = [
+    ("cpu_percent", "CPU", "%", 0.0, 100.0),
+    ("memory_percent", "Memory", "%", 0.0, 100.0),
+    ("disk_usage_percent", "Disk", "%", 0.0, 100.0),
+    ("cpu_temp_celsius", "CPU Temp", "°C", 20.0, 90.0),
+    ("fan_speed_percent", "Fan", "%", 0.0, 100.0),
+]
+
+def sparkline(values: Sequence[float | None], low: float, high: float) -> str:
+    """Renders values as a unicode sparkline, leaving gaps for missing readings."""
+    chars = []
+    for value in values:
+        if value is None:
+            chars.append(" ")
+            continue
+        clamped = min(max(value, low), high)
+        fraction = (clamped - low) / (high - low) if high > low else 0.0
+        chars.append(_SPARK_CHARS[round(fraction * (len(_SPARK_CHARS) - 1))])
+    return "".join(chars)
+
+def render_watch(
+    history: dict[str, deque[float | None]],
+    missing: dict[str, int],
+    temperature_unit: TemperatureUnit = "C",
+) -> str:
+    """Builds the watch screen from the metric history and missing-reading counters."""
+    lines = [f"sat-x watch - {time.strftime('%Y-%m-%d %H:%M:%S')} (Ctrl+C to exit)", ""]
+    for key, label, unit, low, high in _WATCH_FIELDS:
+        values = history[key]
+        latest = values[-1] if values else None
+        if key == "cpu_temp_celsius":
+            # History stays in Celsius; the sparkline shape is the same in any unit
+            unit = temperature_symbol(temperature_unit)
+            if latest is not None:
+                latest = convert_temperature(latest, temperature_unit)
+        reading = f"{latest:6.1f}{unit}" if latest is not None else "   n/a"
+        lines.append(f"{label:<9} {reading:<9} {sparkline(values, low, high)}  (missed: {missing[key]})")
+    return "\n".join(lines)
+
+def run_watch(interval: float, history_size: int, temperature_unit: TemperatureUnit = "C"):
+    """Polls system metrics and redraws the watch screen until interrupted."""
+    history: dict[str, deque[float | None]] = {key: deque(maxlen=history_size) for key, *_ in _WATCH_FIELDS}
+    missing: dict[str, int] = {key: 0 for key, *_ in _WATCH_FIELDS}
+
+    try:
+        while True:
+            metrics = metrics_service.get_system_metrics()
+            for key in history:
+                value = metrics.get(key)
+                history[key].append(value)
+                if value is None:
+                    missing[key] += 1
+            print(_CLEAR_SCREEN + render_watch(history, missing, temperature_unit), flush=True)
+            time.sleep(interval)
+    except KeyboardInterrupt:
+        pass
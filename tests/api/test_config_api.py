@@ -0,0 +1,55 @@
+from fastapi import FastAPI
+from fastapi.testclient import TestClient
+
+from sat_x.config import AlertSettings, Settings, WebhookSettings, get_settings
+
+# --- Test Config Endpoint ---
+
+def test_get_config(test_client: TestClient, test_settings: Settings):
+    """Test the /config endpoint returns the active settings."""
+    response = test_client.get("/api/v1/config")
+    assert response.status_code == 200
+    data = response.json()
+    assert data["database"]["url"] == test_settings.database.url
+    assert data["api"]["token"] is None
+
+def test_get_config_redacts_credentials(test_client: TestClient, test_app: FastAPI, test_settings: Settings):
+    """Webhook URLs and secrets and database passwords must never be returned."""
+    webhook_url = "https://discord.com/api/webhooks/123/abcdef"
+    configured = test_settings.model_copy(update={
+        "database": test_settings.database.model_copy(update={"url": "postgresql+asyncpg://satx:hunter2@db/satx"}),
+        "alerts": AlertSettings(webhooks=[WebhookSettings(name="discord", url=webhook_url, secret="s3cret")]),
+    })
+    test_app.dependency_overrides[get_settings] = lambda: configured
+
+    response = test_client.get("/api/v1/config")
+    assert response.status_code == 200
+    assert webhook_url not in response.text
+    assert "s3cret" not in response.text
+    assert "hunter2" not in response.text
+    data = response.json()
+    assert data["alerts"]["webhooks"][0]["name"] == "discord"
+    assert data["database"]["url"] == "postgresql+asyncpg://satx:***@db/satx"
+
+# --- Test Token Auth ---
+
+def _use_token(test_app: FastAPI, test_settings: Settings, token: str):
+    """Overrides settings so the API requires `token`."""
+    secured = test_settings.model_copy(update={"api": test_settings.api.model_copy(update={"token": token})})
+    test_app.dependency_overrides[get_settings] = lambda: secured
+
+def test_token_required_when_configured(test_client: TestClient, test_app: FastAPI, test_settings: Settings):
+    """Requests without the configured token are rejected, health stays open."""
+    _use_token(test_app, test_settings, "s3cret")
+
+    assert test_client.get("/api/v1/config").status_code == 401
+    assert test_client.get("/api/v1/config", headers={"Authorization": "Bearer wrong"}).status_code == 401
+    assert test_client.get("/api/v1/health").status_code == 200
+
+def test_valid_token_accepted_and_redacted(test_client: TestClient, test_app: FastAPI, test_settings: Settings):
+    """A request with the right token succeeds, and the token itself is not echoed back."""
+    _use_token(test_app, test_settings, "s3cret")
+
+    response = test_client.get("/api/v1/config", headers={"Authorization": "Bearer s3cret"})
+    assert response.status_code == 200
+    assert response.json()["api"]["token"] == "***"
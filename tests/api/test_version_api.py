@@ -0,0 +1,18 @@
+from fastapi.testclient import TestClient
+
+from sat_x.config import Settings
+from sat_x.version import config_fingerprint
+
+# --- Test Version Endpoint ---
+
+def test_get_version(test_client: TestClient, test_settings: Settings):
+    """Test the /version endpoint reports a fingerprint that tracks the config."""
+    response = test_client.get("/api/v1/version")
+    assert response.status_code == 200
+    data = response.json()
+    assert data["version"]
+    assert "build_date" in data
+    assert len(data["config_fingerprint"]) == 12
+
+    changed = test_settings.model_copy(update={"api": test_settings.api.model_copy(update={"port": 9999})})
+    assert config_fingerprint(changed) != data["config_fingerprint"]
@@ -0,0 +1,101 @@
+import asyncio
+
+import pytest
+
+from sat_x.config import AlertRule, AlertSettings, WebhookSettings
+from sat_x.services.alert_service import AlertEvent, AlertService, Notifier
+
+
+class RecordingNotifier(Notifier):
+    def __init__(self):
+        self.events: list[AlertEvent] = []
+
+    async def notify(self, event: AlertEvent):
+        self.events.append(event)
+
+def test_rule_fires_after_hold_time_and_resolves():
+    """A rule should only fire once its condition has held for `for_seconds`."""
+    service = AlertService()
+    rule = AlertRule(name="hot", metric="cpu_temp_celsius", operator=">", threshold=70, for_seconds=30)
+
+    assert service.evaluate({"cpu_temp_celsius": 80.0}, [rule], now=0) == []
+    events = service.evaluate({"cpu_temp_celsius": 81.0}, [rule], now=30)
+    assert [event.state for _, event in events] == ["firing"]
+    # Still hot: no duplicate event while firing
+    assert service.evaluate({"cpu_temp_celsius": 82.0}, [rule], now=60) == []
+
+    events = service.evaluate({"cpu_temp_celsius": 60.0}, [rule], now=90)
+    assert [event.state for _, event in events] == ["resolved"]
+
+def test_missing_operator_fires_on_absent_metric():
+    service = AlertService()
+    rule = AlertRule(name="no_temp", metric="cpu_temp_celsius", operator="missing")
+
+    events = service.evaluate({"cpu_temp_celsius": None}, [rule], now=0)
+    assert [event.state for _, event in events] == ["firing"]
+
+def test_threshold_required_for_comparisons():
+    with pytest.raises(ValueError):
+        AlertRule(name="bad", metric="cpu_percent", operator=">")
+
+def test_unknown_metric_rejected():
+    """A typo in the metric name should fail at config load, not silently never fire."""
+    with pytest.raises(ValueError, match="Unknown metric"):
+        AlertRule(name="typo", metric="cpu_temp", operator=">", threshold=70)
+
+def test_unknown_action_rejected():
+    rule = AlertRule(name="hot", metric="cpu_temp_celsius", operator=">", threshold=70, actions=["slack"])
+    with pytest.raises(ValueError, match="unknown action"):
+        AlertSettings(enabled=True, rules=[rule])
+
+def test_webhook_action_accepted():
+    rule = AlertRule(name="hot", metric="cpu_temp_celsius", operator=">", threshold=70, actions=["log", "slack"])
+    config = AlertSettings(enabled=True, rules=[rule], webhooks=[WebhookSettings(name="slack", url="http://localhost/hook")])
+    assert config.rules[0].actions == ["log", "slack"]
+
+def test_webhook_named_log_rejected():
+    """A webhook called 'log' would silently replace the built-in log notifier."""
+    with pytest.raises(ValueError, match="reserved"):
+        AlertSettings(webhooks=[WebhookSettings(name="log", url="http://localhost/hook")])
+
+@pytest.mark.asyncio
+async def test_process_dispatches_to_registered_notifier():
+    service = AlertService()
+    notifier = RecordingNotifier()
+    # Stand in for the built-in log notifier, which rules use by default
+    service.register_notifier("log", notifier)
+    config = AlertSettings(
+        enabled=True,
+        rules=[AlertRule(name="busy", metric="cpu_percent", operator=">=", threshold=90)],
+    )
+
+    await service.process({"cpu_percent": 95.0}, config)
+    await service.wait_for_notifications()
+
+    assert len(notifier.events) == 1
+    assert notifier.events[0].rule == "busy"
+    assert notifier.events[0].value == 95.0
+
+def test_configure_webhooks_registers_notifier():
+    service = AlertService()
+    service.configure_webhooks([WebhookSettings(name="hook", url="http://localhost/hook")])
+    assert "hook" in service._notifiers
+
+@pytest.mark.asyncio
+async def test_process_does_not_wait_for_slow_notifier():
+    """Collection must not block on notifier delivery (e.g. webhook retries)."""
+    service = AlertService()
+    release = asyncio.Event()
+
+    class BlockingNotifier(Notifier):
+        async def notify(self, event: AlertEvent):
+            await release.wait()
+
+    service.register_notifier("log", BlockingNotifier())
+    config = AlertSettings(enabled=True, rules=[AlertRule(name="busy", metric="cpu_percent", operator=">", threshold=90)])
+
+    await asyncio.wait_for(service.process({"cpu_percent": 95.0}, config), timeout=1)
+    assert len(service._pending_notifications) == 1
+
+    await service.cancel_notifications()
+    assert not service._pending_notifications
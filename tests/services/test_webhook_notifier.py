@@ -0,0 +1,111 @@
+import datetime
+import hashlib
+import hmac
+import json
+
+import httpx
+import pytest
+
+from sat_x.config import WebhookSettings
+from sat_x.services import webhook_notifier
+from sat_x.services.alert_service import AlertEvent
+from sat_x.services.webhook_notifier import SIGNATURE_HEADER, WebhookNotifier, sign_payload
+
+
+def _event() -> AlertEvent:
+    return AlertEvent(
+        rule="hot",
+        metric="cpu_temp_celsius",
+        state="firing",
+        value=80.0,
+        threshold=75.0,
+        timestamp=datetime.datetime(2025, 5, 26, 12, 0, tzinfo=datetime.timezone.utc),  # noqa: UP017
+    )
+
+def test_request_is_signed_when_secret_set():
+    notifier = WebhookNotifier(WebhookSettings(name="hook", url="http://localhost/hook", secret="s3cret"))
+    body, headers = notifier._build_request(_event())
+
+    expected = hmac.new(b"s3cret", body, hashlib.sha256).hexdigest()
+    assert headers[SIGNATURE_HEADER] == f"sha256={expected}"
+    assert sign_payload("s3cret", body) == headers[SIGNATURE_HEADER]
+    assert json.loads(body)["timestamp"] == "2025-05-26T12:00:00+00:00"
+
+def test_request_unsigned_without_secret():
+    notifier = WebhookNotifier(WebhookSettings(name="hook", url="http://localhost/hook"))
+    _, headers = notifier._build_request(_event())
+    assert SIGNATURE_HEADER not in headers
+
+@pytest.fixture
+def backoff_delays(monkeypatch) -> list[float]:
+    """Records backoff delays instead of sleeping."""
+    delays: list[float] = []
+
+    async def fake_sleep(delay: float):
+        delays.append(delay)
+
+    monkeypatch.setattr(webhook_notifier, "_sleep", fake_sleep)
+    return delays
+
+@pytest.mark.asyncio
+async def test_notify_retries_with_backoff_until_success(backoff_delays: list[float]):
+    """Failed deliveries are retried with exponential backoff until one succeeds."""
+    requests: list[httpx.Request] = []
+
+    def handler(request: httpx.Request) -> httpx.Response:
+        requests.append(request)
+        return httpx.Response(503 if len(requests) < 3 else 200)
+
+    config = WebhookSettings(name="hook", url="http://localhost/hook", secret="s3cret", max_retries=3)
+    await WebhookNotifier(config, transport=httpx.MockTransport(handler)).notify(_event())
+
+    assert len(requests) == 3
+    assert backoff_delays == [1, 2]
+    assert all(SIGNATURE_HEADER in request.headers for request in requests)
+
+@pytest.mark.asyncio
+async def test_notify_gives_up_after_max_retries(backoff_delays: list[float]):
+    """An unreachable webhook is attempted max_retries + 1 times, then dropped without raising."""
+    attempts = 0
+
+    def handler(request: httpx.Request) -> httpx.Response:
+        nonlocal attempts
+        attempts += 1
+        raise httpx.ConnectError("unreachable", request=request)
+
+    config = WebhookSettings(name="hook", url="http://localhost/hook", max_retries=2)
+    await WebhookNotifier(config, transport=httpx.MockTransport(handler)).notify(_event())
+
+    assert attempts == 3
+    assert backoff_delays == [1, 2]
+
+
+@pytest.mark.asyncio
+async def test_notify_does_not_retry_client_errors(backoff_delays: list[float]):
+    """A 4xx other than 429 won't succeed on retry, so it's attempted once."""
+    attempts = 0
+
+    def handler(request: httpx.Request) -> httpx.Response:
+        nonlocal attempts
+        attempts += 1
+        return httpx.Response(404)
+
+    config = WebhookSettings(name="hook", url="http://localhost/hook", max_retries=3)
+    await WebhookNotifier(config, transport=httpx.MockTransport(handler)).notify(_event())
+
+    assert attempts == 1
+    assert backoff_delays == []
+
+@pytest.mark.asyncio
+async def test_notify_retries_rate_limiting(backoff_delays: list[float]):
+    """429 responses are retried like server errors."""
+    statuses = [429, 200]
+
+    def handler(request: httpx.Request) -> httpx.Response:
+        return httpx.Response(statuses.pop(0))
+
+    config = WebhookSettings(name="hook", url="http://localhost/hook", max_retries=3)
+    await WebhookNotifier(config, transport=httpx.MockTransport(handler)).notify(_event())
+
+    assert statuses == []
+    assert backoff_delays == [1]
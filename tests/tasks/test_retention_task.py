@@ -0,0 +1,81 @@
+from datetime import datetime, timedelta, timezone
+
+import pytest
+from sqlalchemy import func, select
+from sqlalchemy.ext.asyncio import AsyncSession, async_sessionmaker
+
+from sat_x.config import RetentionTaskSettings
+from sat_x.models import Metric
+from sat_x.services.storage_service import storage_service
+from sat_x.tasks.retention_task import prune_metrics
+
+TEST_DATABASE_URL = "sqlite+aiosqlite:///:memory:"
+
+async def _add_metrics(session: AsyncSession, ages_in_days: list[int]):
+    now = datetime.now(timezone.utc)  # noqa: UP017
+    session.add_all([Metric(timestamp=now - timedelta(days=age), cpu_percent=10.0) for age in ages_in_days])
+    await session.commit()
+
+async def _count(session: AsyncSession) -> int:
+    result = await session.execute(select(func.count(Metric.id)))
+    return result.scalar_one()
+
+@pytest.fixture(autouse=True)
+def reset_low_space():
+    storage_service.low_space = False
+    yield
+    storage_service.low_space = False
+
+@pytest.mark.asyncio
+async def test_prune_deletes_expired_metrics(
+    setup_database,
+    test_session_factory: async_sessionmaker[AsyncSession],
+    monkeypatch
+):
+    """Metrics older than max_age_days should be removed, newer ones kept."""
+    monkeypatch.setattr(storage_service, "get_free_percent", lambda url: 50.0)
+    async with test_session_factory() as session:
+        await _add_metrics(session, [40, 35, 1])
+
+        await prune_metrics(session, RetentionTaskSettings(max_age_days=30), TEST_DATABASE_URL)
+
+        assert await _count(session) == 1
+        assert storage_service.low_space is False
+
+@pytest.mark.asyncio
+async def test_prune_frees_space_when_low(
+    setup_database,
+    test_session_factory: async_sessionmaker[AsyncSession],
+    monkeypatch
+):
+    """When free space is below the threshold, the oldest batch is deleted and storage decimated."""
+    monkeypatch.setattr(storage_service, "get_free_percent", lambda url: 5.0)
+    async with test_session_factory() as session:
+        await _add_metrics(session, [3, 2, 1])
+
+        config = RetentionTaskSettings(max_age_days=None, min_free_percent=10, prune_batch_size=2, min_rows_kept=0)
+        await prune_metrics(session, config, TEST_DATABASE_URL)
+
+        assert await _count(session) == 1
+        assert storage_service.low_space is True
+
+@pytest.mark.asyncio
+async def test_prune_keeps_minimum_history_when_space_stays_low(
+    setup_database,
+    test_session_factory: async_sessionmaker[AsyncSession],
+    monkeypatch
+):
+    """Deleting rows doesn't shrink SQLite, so repeated low-space runs must not wipe all history."""
+    monkeypatch.setattr(storage_service, "get_free_percent", lambda url: 5.0)
+    async with test_session_factory() as session:
+        await _add_metrics(session, list(range(10, 0, -1)))
+
+        config = RetentionTaskSettings(max_age_days=None, min_free_percent=10, prune_batch_size=3, min_rows_kept=4)
+        for _ in range(5):
+            await prune_metrics(session, config, TEST_DATABASE_URL)
+
+        assert await _count(session) == 4
+        # The newest records are the ones kept
+        result = await session.execute(select(func.min(Metric.timestamp)))
+        oldest_kept = result.scalar_one()
+        assert oldest_kept.replace(tzinfo=timezone.utc) > datetime.now(timezone.utc) - timedelta(days=5)  # noqa: UP017
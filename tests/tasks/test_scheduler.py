@@ -0,0 +1,74 @@
+import pytest
+
+from sat_x.tasks import scheduler
+from sat_x.tasks.scheduler import run_periodic, skipped_runs
+
+
+class StopScheduler(Exception):
+    """Raised by the fake sleep to end the otherwise infinite scheduler loop."""
+
+class FakeClock:
+    """Replaces the scheduler's clock and sleep so tests don't depend on real time."""
+
+    def __init__(self, max_sleeps: int):
+        self.now = 1000.0
+        self.sleeps: list[float] = []
+        self._max_sleeps = max_sleeps
+
+    def time(self) -> float:
+        return self.now
+
+    async def sleep(self, delay: float):
+        if len(self.sleeps) == self._max_sleeps:
+            raise StopScheduler
+        self.sleeps.append(delay)
+        self.now += delay
+
+@pytest.fixture
+def fake_clock(monkeypatch) -> FakeClock:
+    clock = FakeClock(max_sleeps=3)
+    monkeypatch.setattr(scheduler, "_now", clock.time)
+    monkeypatch.setattr(scheduler, "_sleep", clock.sleep)
+    return clock
+
+@pytest.mark.asyncio
+async def test_run_periodic_waits_for_offset(fake_clock: FakeClock):
+    """The first run happens after the offset, then on every interval."""
+    run_times: list[float] = []
+
+    async def job():
+        run_times.append(fake_clock.now)
+
+    with pytest.raises(StopScheduler):
+        await run_periodic("offset-test", 10, job, offset=2)
+
+    assert fake_clock.sleeps == [2, 10, 10]
+    assert run_times == [1002, 1012, 1022]
+
+@pytest.mark.asyncio
+async def test_run_periodic_skips_overrun_deadlines(fake_clock: FakeClock):
+    """A run that overruns its interval skips the missed deadlines and keeps the original phase."""
+    run_times: list[float] = []
+
+    async def job():
+        run_times.append(fake_clock.now)
+        if len(run_times) == 1:
+            fake_clock.now += 25 # Overrun a 10s interval past two deadlines
+
+    with pytest.raises(StopScheduler):
+        await run_periodic("overrun-test", 10, job)
+
+    # Deadlines at +10 and +20 are skipped; the next run lands on +30
+    assert run_times == [1000, 1030, 1040]
+
+def test_skipped_runs_none_when_on_time():
+    """A run that finishes before its next deadline skips nothing."""
+    assert skipped_runs(next_run=10.0, now=9.5, interval=1.0) == 0
+    assert skipped_runs(next_run=10.0, now=10.0, interval=1.0) == 0
+
+def test_skipped_runs_counts_passed_deadlines():
+    """Every deadline that has already passed counts as missed."""
+    # Deadlines at 10.0 and 11.0 have passed; 12.0 is still ahead
+    assert skipped_runs(next_run=10.0, now=11.5, interval=1.0) == 2
+    # Just past a single deadline
+    assert skipped_runs(next_run=10.0, now=10.01, interval=1.0) == 1
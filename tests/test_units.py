@@ -0,0 +1,20 @@
+import pytest
+
+from sat_x.units import convert_temperature, format_temperature, temperature_symbol
+
+
+@pytest.mark.parametrize(
+    ("unit", "expected"),
+    [("C", 25.0), ("F", 77.0), ("K", 298.15)],
+)
+def test_convert_temperature(unit, expected):
+    """Celsius readings should convert to each supported display unit."""
+    assert convert_temperature(25.0, unit) == pytest.approx(expected)
+
+def test_temperature_symbol():
+    assert temperature_symbol("C") == "°C"
+    assert temperature_symbol("K") == "K"
+
+def test_format_temperature():
+    assert format_temperature(55.0, "C") == "55.0°C"
+    assert format_temperature(100.0, "F") == "212.0°F"
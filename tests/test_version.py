@@ -0,0 +1,25 @@
+import json
+
+from sat_x.build_info import write_build_info
+from sat_x.config import Settings
+from sat_x.version import config_fingerprint, get_build_info
+
+
+def test_build_info_is_json_serializable(test_settings: Settings):
+    """`sat-x version --json` dumps the build info as a single JSON object."""
+    info = json.loads(json.dumps(get_build_info(test_settings)))
+    assert set(info) == {"version", "commit", "build_date", "config_fingerprint"}
+
+def test_config_fingerprint_ignores_secrets(test_settings: Settings):
+    """Credentials are redacted before hashing, so rotating a token keeps the fingerprint."""
+    with_token = test_settings.model_copy(update={"api": test_settings.api.model_copy(update={"token": "s3cret"})})
+    rotated = test_settings.model_copy(update={"api": test_settings.api.model_copy(update={"token": "rotated"})})
+    assert config_fingerprint(with_token) == config_fingerprint(rotated)
+
+def test_write_build_info(tmp_path):
+    """The generated module records the commit and build date as plain constants."""
+    path = write_build_info(tmp_path / "_build_info.py")
+    namespace: dict[str, object] = {}
+    exec(path.read_text(), namespace)
+    assert "COMMIT" in namespace
+    assert isinstance(namespace["BUILD_DATE"], str)
@@ -0,0 +1,45 @@
+from collections import deque
+
+from sat_x.watch import _WATCH_FIELDS, render_watch, sparkline
+
+
+def _empty_history() -> dict[str, deque[float | None]]:
+    return {key: deque(maxlen=10) for key, *_ in _WATCH_FIELDS}
+
+def _no_missing() -> dict[str, int]:
+    return {key: 0 for key, *_ in _WATCH_FIELDS}
+
+# --- Test sparkline ---
+
+def test_sparkline_scales_across_range():
+    """The lowest and highest values map to the first and last characters."""
+    assert sparkline([0.0, 50.0, 100.0], 0.0, 100.0) == "▁▅█"
+
+def test_sparkline_clamps_out_of_range_values():
+    assert sparkline([-20.0, 150.0], 0.0, 100.0) == "▁█"
+
+def test_sparkline_leaves_gaps_for_missing_values():
+    assert sparkline([0.0, None, 100.0], 0.0, 100.0) == "▁ █"
+
+def test_sparkline_handles_empty_range():
+    """A zero-width range shouldn't divide by zero."""
+    assert sparkline([5.0, 5.0], 5.0, 5.0) == "▁▁"
+
+# --- Test render_watch ---
+
+def test_render_watch_shows_na_for_missing_reading():
+    history = _empty_history()
+    missing = _no_missing()
+    history["fan_speed_percent"].append(None)
+    missing["fan_speed_percent"] = 3
+
+    fan_line = next(line for line in render_watch(history, missing).splitlines() if line.startswith("Fan"))
+    assert "n/a" in fan_line
+    assert "(missed: 3)" in fan_line
+
+def test_render_watch_converts_temperature_unit():
+    history = _empty_history()
+    history["cpu_temp_celsius"].append(25.0)
+
+    temp_line = next(line for line in render_watch(history, _no_missing(), "F").splitlines() if line.startswith("CPU Temp"))
+    assert "77.0°F" in temp_line